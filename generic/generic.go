@@ -0,0 +1,135 @@
+// Copyright 2012 Kamil Kisiel. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package generic provides a type-parameterized set API that complements the
+// reflect-based one in the parent set package. Because Set[T] is defined in
+// terms of Go generics, operations on it are type-checked at compile time:
+// there are no type assertions and no possibility of the runtime panics that
+// StringSet and IntSet are prone to when misused.
+package generic
+
+import "iter"
+
+// Set is a collection of unique, comparable elements of type T.
+type Set[T comparable] interface {
+	// Len is the number of elements in the set.
+	Len() int
+
+	// Contains returns whether x is in the set.
+	Contains(x T) bool
+
+	// Insert inserts x into the set.
+	Insert(x T)
+
+	// Remove removes x from the set.
+	Remove(x T)
+
+	// Iter returns an iterator over the elements of the set. As with map
+	// iteration, modifying the set during iteration is forbidden.
+	Iter() iter.Seq[T]
+}
+
+// MapSet is the default Set[T] implementation, backed by a Go map.
+type MapSet[T comparable] map[T]struct{}
+
+// NewMapSet returns a new, empty MapSet.
+func NewMapSet[T comparable]() MapSet[T] {
+	return make(MapSet[T])
+}
+
+func (s MapSet[T]) Len() int {
+	return len(s)
+}
+
+func (s MapSet[T]) Contains(x T) bool {
+	_, ok := s[x]
+	return ok
+}
+
+func (s MapSet[T]) Insert(x T) {
+	s[x] = struct{}{}
+}
+
+func (s MapSet[T]) Remove(x T) {
+	delete(s, x)
+}
+
+func (s MapSet[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Union returns a new set containing all the elements of s and t.
+func Union[T comparable](s, t Set[T]) Set[T] {
+	r := NewMapSet[T]()
+	for v := range s.Iter() {
+		r.Insert(v)
+	}
+	for v := range t.Iter() {
+		r.Insert(v)
+	}
+	return r
+}
+
+// Intersection returns a new set containing the elements that are in both s and t.
+func Intersection[T comparable](s, t Set[T]) Set[T] {
+	r := NewMapSet[T]()
+	if s.Len() > t.Len() {
+		s, t = t, s
+	}
+	for v := range s.Iter() {
+		if t.Contains(v) {
+			r.Insert(v)
+		}
+	}
+	return r
+}
+
+// Difference returns a new set containing the elements that are in s but not t.
+func Difference[T comparable](s, t Set[T]) Set[T] {
+	r := NewMapSet[T]()
+	for v := range s.Iter() {
+		if !t.Contains(v) {
+			r.Insert(v)
+		}
+	}
+	return r
+}
+
+// SymmetricDifference returns a new set containing the elements in s that are
+// not in t and the elements in t that are not in s.
+func SymmetricDifference[T comparable](s, t Set[T]) Set[T] {
+	r := NewMapSet[T]()
+	for v := range s.Iter() {
+		if !t.Contains(v) {
+			r.Insert(v)
+		}
+	}
+	for v := range t.Iter() {
+		if !s.Contains(v) {
+			r.Insert(v)
+		}
+	}
+	return r
+}
+
+// IsSubset returns whether every element of s is also in t.
+func IsSubset[T comparable](s, t Set[T]) bool {
+	for v := range s.Iter() {
+		if !t.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns whether s and t contain exactly the same elements.
+func Equal[T comparable](s, t Set[T]) bool {
+	return s.Len() == t.Len() && IsSubset(s, t)
+}
@@ -0,0 +1,50 @@
+package generic
+
+import "testing"
+
+func checkSet[T comparable](s Set[T], vals []T, t *testing.T) {
+	if l := s.Len(); l != len(vals) {
+		t.Errorf("expected len == %d, got %d", len(vals), l)
+	}
+	for _, v := range vals {
+		if !s.Contains(v) {
+			t.Errorf("set does not contain %v", v)
+		}
+	}
+}
+
+func TestMapSet(t *testing.T) {
+	a := NewMapSet[string]()
+	a.Insert("a")
+	a.Insert("b")
+	a.Insert("c")
+	b := NewMapSet[string]()
+	b.Insert("b")
+	b.Insert("c")
+	b.Insert("d")
+
+	u := Union[string](a, b)
+	checkSet[string](u, []string{"a", "b", "c", "d"}, t)
+
+	i := Intersection[string](a, b)
+	checkSet[string](i, []string{"b", "c"}, t)
+
+	d := Difference[string](a, b)
+	checkSet[string](d, []string{"a"}, t)
+
+	sd := SymmetricDifference[string](a, b)
+	checkSet[string](sd, []string{"a", "d"}, t)
+
+	if IsSubset[string](a, b) {
+		t.Errorf("a should not be a subset of b")
+	}
+	if !IsSubset[string](i, a) {
+		t.Errorf("intersection should be a subset of a")
+	}
+	if Equal[string](a, b) {
+		t.Errorf("a and b should not be equal")
+	}
+	if !Equal[string](a, a) {
+		t.Errorf("a should be equal to itself")
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2012 Kamil Kisiel. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Equal returns whether s and t contain exactly the same elements.
+func Equal(s, t Interface) bool {
+	return s.Len() == t.Len() && IsSubset(s, t)
+}
+
+// IsSubset returns whether every element of s is also an element of t.
+func IsSubset(s, t Interface) bool {
+	it := s.Iterator()
+	defer it.Stop()
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		if !t.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubset returns whether s is a subset of t and s and t are not equal.
+func IsProperSubset(s, t Interface) bool {
+	return s.Len() < t.Len() && IsSubset(s, t)
+}
+
+// IsDisjoint returns whether s and t have no elements in common.
+func IsDisjoint(s, t Interface) bool {
+	it := s.Iterator()
+	defer it.Stop()
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		if t.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a new set of the same concrete type as s, containing the same elements.
+func Clone(s Interface) Interface {
+	r := newSet(s, s, s.Len())
+	iterate(s.Iterator(), r.Insert)
+	return r
+}
+
+// Pop removes and returns an arbitrary element of s, or nil if s is empty.
+// StringSet, IntSet and GenericSet take an O(1) path straight over their
+// underlying map; other Interface implementations fall back to Iterator.
+func Pop(s Interface) interface{} {
+	switch m := s.(type) {
+	case StringSet:
+		for k := range m {
+			delete(m, k)
+			return k
+		}
+		return nil
+	case IntSet:
+		for k := range m {
+			delete(m, k)
+			return k
+		}
+		return nil
+	case GenericSet:
+		for k := range m {
+			delete(m, k)
+			return k
+		}
+		return nil
+	}
+
+	it := s.Iterator()
+	defer it.Stop()
+	v, ok := it.Next()
+	if !ok {
+		return nil
+	}
+	s.Remove(v)
+	return v
+}
+
+// ToSlice returns the elements of s as a []interface{}, in no particular order.
+func ToSlice(s Interface) []interface{} {
+	r := make([]interface{}, 0, s.Len())
+	iterate(s.Iterator(), func(v interface{}) {
+		r = append(r, v)
+	})
+	return r
+}
+
+// GenericSet is an Interface implementation backed by a map[interface{}]struct{}.
+// Unlike StringSet and IntSet it can hold a mix of any comparable values, which
+// makes it suitable as the concrete type returned by PowerSet and
+// CartesianProduct. Insert panics if x's type is not comparable.
+type GenericSet map[interface{}]struct{}
+
+// NewGenericSet returns a new, empty GenericSet.
+func NewGenericSet() GenericSet {
+	return make(GenericSet)
+}
+
+func (s GenericSet) Len() int {
+	return len(s)
+}
+
+func (s GenericSet) Contains(x interface{}) bool {
+	_, ok := s[x]
+	return ok
+}
+
+func (s GenericSet) Insert(x interface{}) {
+	if x != nil && !reflect.TypeOf(x).Comparable() {
+		panic(fmt.Sprintf("set: cannot insert value of non-comparable type %T into a GenericSet", x))
+	}
+	s[x] = struct{}{}
+}
+
+func (s GenericSet) Remove(x interface{}) {
+	delete(s, x)
+}
+
+func (s GenericSet) Iterator() Iterator {
+	vals := make([]interface{}, 0, len(s))
+	for v := range s {
+		vals = append(vals, v)
+	}
+	return &sliceIterator{vals: vals}
+}
+
+// Deprecated: use Iterator instead.
+func (s GenericSet) Values() <-chan interface{} {
+	c := make(chan interface{})
+	go func() {
+		iterate(s.Iterator(), func(v interface{}) { c <- v })
+		close(c)
+	}()
+	return c
+}
+
+// PowerSet returns the power set of s: a GenericSet whose elements are the
+// *GenericSet of every subset of s, including the empty set and s itself.
+// Subsets are stored by pointer because GenericSet, like any map, is not
+// itself comparable and so cannot be used as a map key directly.
+func PowerSet(s Interface) Interface {
+	elems := ToSlice(s)
+	r := NewGenericSet()
+	for i := 0; i < 1<<uint(len(elems)); i++ {
+		sub := NewGenericSet()
+		for j, e := range elems {
+			if i&(1<<uint(j)) != 0 {
+				sub.Insert(e)
+			}
+		}
+		r.Insert(&sub)
+	}
+	return r
+}
+
+// CartesianProduct returns a GenericSet containing every pair (x, y), represented
+// as a [2]interface{}, with x drawn from s and y drawn from t.
+func CartesianProduct(s, t Interface) Interface {
+	r := NewGenericSet()
+	sit := s.Iterator()
+	defer sit.Stop()
+	for sv, ok := sit.Next(); ok; sv, ok = sit.Next() {
+		tit := t.Iterator()
+		for tv, ok := tit.Next(); ok; tv, ok = tit.Next() {
+			r.Insert([2]interface{}{sv, tv})
+		}
+		tit.Stop()
+	}
+	return r
+}
@@ -0,0 +1,105 @@
+package set
+
+import (
+	"testing"
+)
+
+func TestPredicates(t *testing.T) {
+	a := make(StringSet)
+	a.Insert("a")
+	a.Insert("b")
+
+	b := make(StringSet)
+	b.Insert("a")
+	b.Insert("b")
+	b.Insert("c")
+
+	if !IsSubset(a, b) {
+		t.Errorf("expected a to be a subset of b")
+	}
+	if IsSubset(b, a) {
+		t.Errorf("expected b to not be a subset of a")
+	}
+	if !IsProperSubset(a, b) {
+		t.Errorf("expected a to be a proper subset of b")
+	}
+	if IsProperSubset(a, a) {
+		t.Errorf("expected a to not be a proper subset of itself")
+	}
+	if Equal(a, b) {
+		t.Errorf("expected a and b to not be equal")
+	}
+	if !Equal(a, Clone(a)) {
+		t.Errorf("expected a to equal its clone")
+	}
+
+	c := make(StringSet)
+	c.Insert("z")
+	if !IsDisjoint(a, c) {
+		t.Errorf("expected a and c to be disjoint")
+	}
+	if IsDisjoint(a, b) {
+		t.Errorf("expected a and b to not be disjoint")
+	}
+
+	clone := Clone(a)
+	if clone.Len() != a.Len() {
+		t.Errorf("expected clone of len %d, got %d", a.Len(), clone.Len())
+	}
+
+	slice := ToSlice(a)
+	if len(slice) != a.Len() {
+		t.Errorf("expected slice of len %d, got %d", a.Len(), len(slice))
+	}
+
+	popped := Pop(clone)
+	if popped == nil {
+		t.Errorf("expected a popped value")
+	}
+	if clone.Len() != a.Len()-1 {
+		t.Errorf("expected clone to shrink after Pop")
+	}
+	if Pop(make(StringSet)) != nil {
+		t.Errorf("expected Pop of an empty set to return nil")
+	}
+}
+
+func TestGenericSetNil(t *testing.T) {
+	s := NewGenericSet()
+	s.Insert(nil)
+	if !s.Contains(nil) {
+		t.Errorf("expected set to contain nil after Insert(nil)")
+	}
+	if s.Len() != 1 {
+		t.Errorf("expected len 1, got %d", s.Len())
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	a := make(StringSet)
+	a.Insert("a")
+	a.Insert("b")
+
+	ps := PowerSet(a)
+	if ps.Len() != 4 {
+		t.Errorf("expected power set of len 4, got %d", ps.Len())
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	a := make(StringSet)
+	a.Insert("a")
+	a.Insert("b")
+
+	b := make(IntSet)
+	b.Insert(1)
+	b.Insert(2)
+
+	cp := CartesianProduct(a, b)
+	if cp.Len() != 4 {
+		t.Errorf("expected cartesian product of len 4, got %d", cp.Len())
+	}
+	if !cp.Contains([2]interface{}{"a", 1}) {
+		t.Errorf("expected cartesian product to contain (a, 1)")
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2012 Kamil Kisiel. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON marshals any Interface implementation to a JSON array of its
+// elements, walking it via its Iterator. This is a generic fallback for
+// user-defined implementations of Interface; StringSet and IntSet provide
+// their own, more efficient json.Marshaler implementations.
+func MarshalJSON(s Interface) ([]byte, error) {
+	return json.Marshal(ToSlice(s))
+}
+
+// MarshalJSON implements json.Marshaler, encoding s as a JSON array of its
+// elements rather than as an object of the underlying map[string]bool.
+func (s StringSet) MarshalJSON() ([]byte, error) {
+	vals := make([]string, 0, len(s))
+	for v := range s {
+		vals = append(vals, v)
+	}
+	return json.Marshal(vals)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a JSON array of strings
+// produced by MarshalJSON.
+func (s *StringSet) UnmarshalJSON(data []byte) error {
+	var vals []string
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return err
+	}
+	set := make(StringSet, len(vals))
+	for _, v := range vals {
+		set.Insert(v)
+	}
+	*s = set
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder, encoding s as a gob-encoded []string
+// rather than as the underlying map[string]bool.
+func (s StringSet) GobEncode() ([]byte, error) {
+	vals := make([]string, 0, len(s))
+	for v := range s {
+		vals = append(vals, v)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(vals); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, decoding the []string produced by GobEncode.
+func (s *StringSet) GobDecode(data []byte) error {
+	var vals []string
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&vals); err != nil {
+		return err
+	}
+	set := make(StringSet, len(vals))
+	for _, v := range vals {
+		set.Insert(v)
+	}
+	*s = set
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding s as a JSON array of its
+// elements rather than as an object of the underlying map[int]bool.
+func (s IntSet) MarshalJSON() ([]byte, error) {
+	vals := make([]int, 0, len(s))
+	for v := range s {
+		vals = append(vals, v)
+	}
+	return json.Marshal(vals)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a JSON array of ints
+// produced by MarshalJSON.
+func (s *IntSet) UnmarshalJSON(data []byte) error {
+	var vals []int
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return err
+	}
+	set := make(IntSet, len(vals))
+	for _, v := range vals {
+		set.Insert(v)
+	}
+	*s = set
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder, encoding s as a gob-encoded []int
+// rather than as the underlying map[int]bool.
+func (s IntSet) GobEncode() ([]byte, error) {
+	vals := make([]int, 0, len(s))
+	for v := range s {
+		vals = append(vals, v)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(vals); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, decoding the []int produced by GobEncode.
+func (s *IntSet) GobDecode(data []byte) error {
+	var vals []int
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&vals); err != nil {
+		return err
+	}
+	set := make(IntSet, len(vals))
+	for _, v := range vals {
+		set.Insert(v)
+	}
+	*s = set
+	return nil
+}
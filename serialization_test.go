@@ -0,0 +1,94 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"sort"
+	"testing"
+)
+
+func TestStringSetJSON(t *testing.T) {
+	a := make(StringSet)
+	a.Insert("a")
+	a.Insert("b")
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var vals []string
+	if err := json.Unmarshal(data, &vals); err != nil {
+		t.Fatalf("expected a JSON array, got error: %v", err)
+	}
+	sort.Strings(vals)
+	if len(vals) != 2 || vals[0] != "a" || vals[1] != "b" {
+		t.Errorf("unexpected JSON array: %v", vals)
+	}
+
+	var b StringSet
+	if err := json.Unmarshal(data, &b); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !Equal(a, b) {
+		t.Errorf("expected round-tripped set to equal original")
+	}
+}
+
+func TestStringSetGob(t *testing.T) {
+	a := make(StringSet)
+	a.Insert("a")
+	a.Insert("b")
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(a); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	var b StringSet
+	if err := gob.NewDecoder(&buf).Decode(&b); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if !Equal(a, b) {
+		t.Errorf("expected round-tripped set to equal original")
+	}
+}
+
+func TestIntSetJSON(t *testing.T) {
+	a := make(IntSet)
+	a.Insert(1)
+	a.Insert(2)
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var b IntSet
+	if err := json.Unmarshal(data, &b); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !Equal(a, b) {
+		t.Errorf("expected round-tripped set to equal original")
+	}
+}
+
+func TestMarshalJSONInterface(t *testing.T) {
+	a := make(StringSet)
+	a.Insert("a")
+	a.Insert("b")
+
+	data, err := MarshalJSON(a)
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+
+	var vals []string
+	if err := json.Unmarshal(data, &vals); err != nil {
+		t.Fatalf("expected a JSON array, got error: %v", err)
+	}
+	if len(vals) != 2 {
+		t.Errorf("expected 2 values, got %d", len(vals))
+	}
+}
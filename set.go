@@ -8,7 +8,7 @@
 // If the types of the arguments do not match the functions will panic.
 // The concrete type of the return value will be the same as that of the inputs.
 //
-// A type implementing set.Interface must be map, slice, or a struct.
+// A type implementing set.Interface must be a map, a slice, a struct, or a pointer to a struct.
 // Other types will result in a panic when trying to call any of the routines expecting an Interface instance.
 package set
 
@@ -34,13 +34,60 @@ type Interface interface {
 	// Remove removes an element from the collection.
 	Remove(x interface{})
 
+	// Iterator returns an Iterator over the values in the collection.
+	Iterator() Iterator
+
 	// Values returns a channel that produces all of the values in the collection.
 	// Implementors must close the channel after sending the last value.
 	// Calling Interface.Insert or Interface.Remove or other operations that modify the collection is forbidden
 	// until the channel return by Values has been closed, otherwise the result is undefined.
+	//
+	// Deprecated: use Iterator instead. Values spawns a goroutine that leaks if the
+	// channel is not drained to completion.
 	Values() <-chan interface{}
 }
 
+// Iterator provides pull-style iteration over the values of a collection. Unlike
+// the channel returned by Values, an Iterator does not require a backing goroutine
+// and can be abandoned at any time, by simply ceasing to call Next, without leaking
+// anything.
+type Iterator interface {
+	// Next returns the next value in the collection and true, or a zero value and
+	// false if there are no more values.
+	Next() (interface{}, bool)
+
+	// Stop indicates that the caller is done with the iterator. It is safe to call
+	// Stop more than once, and safe to omit calling it once Next has returned false.
+	Stop()
+}
+
+// sliceIterator is an Iterator over a pre-collected slice of values.
+type sliceIterator struct {
+	vals []interface{}
+	pos  int
+}
+
+func (it *sliceIterator) Next() (interface{}, bool) {
+	if it.pos >= len(it.vals) {
+		return nil, false
+	}
+	v := it.vals[it.pos]
+	it.pos++
+	return v, true
+}
+
+func (it *sliceIterator) Stop() {
+	it.pos = len(it.vals)
+}
+
+// iterate calls f with each value produced by it, then stops it.
+func iterate(it Iterator, f func(interface{})) {
+	defer it.Stop()
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		f(v)
+	}
+}
+
 // newSet creates a new set of the same type as s and t, after ensuring they are of the same type.
 func newSet(s, t Interface, capacity int) Interface {
 	sType := reflect.TypeOf(s)
@@ -57,6 +104,8 @@ func newSet(s, t Interface, capacity int) Interface {
 		r = reflect.MakeSlice(sType, 0, capacity).Interface().(Interface)
 	case reflect.Struct:
 		r = reflect.Zero(sType).Interface().(Interface)
+	case reflect.Ptr:
+		r = reflect.New(sType.Elem()).Interface().(Interface)
 	default:
 		panic(fmt.Sprintf("Unsupported set type: %s", sType))
 	}
@@ -66,14 +115,8 @@ func newSet(s, t Interface, capacity int) Interface {
 // Union returns a new set containing all the elements of s and t.
 func Union(s, t Interface) Interface {
 	r := newSet(s, t, s.Len()+t.Len())
-	svals := s.Values()
-	for v := range svals {
-		r.Insert(v)
-	}
-	tvals := t.Values()
-	for v := range tvals {
-		r.Insert(v)
-	}
+	iterate(s.Iterator(), r.Insert)
+	iterate(t.Iterator(), r.Insert)
 	return r
 }
 
@@ -83,42 +126,38 @@ func Intersection(s, t Interface) Interface {
 	if s.Len() < t.Len() {
 		t, s = s, t
 	}
-	svals := s.Values()
-	for v := range svals {
+	iterate(s.Iterator(), func(v interface{}) {
 		if t.Contains(v) {
 			r.Insert(v)
 		}
-	}
+	})
 	return r
 }
 
 // Difference returns a new set containing the elements that are in s but not t.
 func Difference(s, t Interface) Interface {
 	r := newSet(s, t, 0)
-	svals := s.Values()
-	for v := range svals {
+	iterate(s.Iterator(), func(v interface{}) {
 		if !t.Contains(v) {
 			r.Insert(v)
 		}
-	}
+	})
 	return r
 }
 
 // SymmetricDifference returns a new set containing the elements in s that are not in t and the elements in t that are not in s.
 func SymmetricDifference(s, t Interface) Interface {
 	r := newSet(s, t, 0)
-	svals := s.Values()
-	for v := range svals {
+	iterate(s.Iterator(), func(v interface{}) {
 		if !t.Contains(v) {
 			r.Insert(v)
 		}
-	}
-	tvals := t.Values()
-	for v := range tvals {
+	})
+	iterate(t.Iterator(), func(v interface{}) {
 		if !s.Contains(v) {
 			r.Insert(v)
 		}
-	}
+	})
 	return r
 }
 
@@ -142,12 +181,19 @@ func (s StringSet) Remove(x interface{}) {
 	delete(s, x.(string))
 }
 
+func (s StringSet) Iterator() Iterator {
+	vals := make([]interface{}, 0, len(s))
+	for v := range s {
+		vals = append(vals, v)
+	}
+	return &sliceIterator{vals: vals}
+}
+
+// Deprecated: use Iterator instead.
 func (s StringSet) Values() <-chan interface{} {
 	c := make(chan interface{})
 	go func() {
-		for v := range s {
-			c <- v
-		}
+		iterate(s.Iterator(), func(v interface{}) { c <- v })
 		close(c)
 	}()
 	return c
@@ -173,12 +219,19 @@ func (s IntSet) Remove(x interface{}) {
 	delete(s, x.(int))
 }
 
+func (s IntSet) Iterator() Iterator {
+	vals := make([]interface{}, 0, len(s))
+	for v := range s {
+		vals = append(vals, v)
+	}
+	return &sliceIterator{vals: vals}
+}
+
+// Deprecated: use Iterator instead.
 func (s IntSet) Values() <-chan interface{} {
 	c := make(chan interface{})
 	go func() {
-		for v := range s {
-			c <- v
-		}
+		iterate(s.Iterator(), func(v interface{}) { c <- v })
 		close(c)
 	}()
 	return c
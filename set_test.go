@@ -42,3 +42,30 @@ func TestSet(t *testing.T) {
 	sdvals := []interface{}{"a", "d"}
 	checkSet(sd, sdvals, t)
 }
+
+func TestIterator(t *testing.T) {
+	a := make(StringSet)
+	a.Insert("a")
+	a.Insert("b")
+	a.Insert("c")
+
+	seen := make(map[interface{}]bool)
+	it := a.Iterator()
+	for {
+		v, ok := it.Next()
+		if !ok {
+			break
+		}
+		seen[v] = true
+	}
+	if len(seen) != a.Len() {
+		t.Errorf("expected %d values, got %d", a.Len(), len(seen))
+	}
+
+	// Stopping early should not panic or leak.
+	it = a.Iterator()
+	if _, ok := it.Next(); !ok {
+		t.Errorf("expected a value, got none")
+	}
+	it.Stop()
+}
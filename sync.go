@@ -0,0 +1,131 @@
+// Copyright 2012 Kamil Kisiel. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package set
+
+import "sync"
+
+// SyncStringSet is a concurrency-safe variant of StringSet. Its zero value is
+// an empty, usable set. It guards its underlying map with a sync.RWMutex so
+// that it may safely be shared across goroutines.
+type SyncStringSet struct {
+	mu sync.RWMutex
+	s  StringSet
+}
+
+// NewSyncStringSet returns a new, empty SyncStringSet.
+func NewSyncStringSet() *SyncStringSet {
+	return &SyncStringSet{s: make(StringSet)}
+}
+
+func (s *SyncStringSet) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Len()
+}
+
+func (s *SyncStringSet) Contains(x interface{}) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Contains(x)
+}
+
+func (s *SyncStringSet) Insert(x interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.s == nil {
+		s.s = make(StringSet)
+	}
+	s.s.Insert(x)
+}
+
+func (s *SyncStringSet) Remove(x interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.s.Remove(x)
+}
+
+func (s *SyncStringSet) Iterator() Iterator {
+	return s.Clone().Iterator()
+}
+
+// Deprecated: use Iterator instead.
+func (s *SyncStringSet) Values() <-chan interface{} {
+	return s.Clone().Values()
+}
+
+// Clone returns a snapshot copy of the set as a plain StringSet, so that
+// callers can iterate over or otherwise inspect it without holding the
+// SyncStringSet's lock.
+func (s *SyncStringSet) Clone() StringSet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c := make(StringSet, len(s.s))
+	for k, v := range s.s {
+		c[k] = v
+	}
+	return c
+}
+
+// SyncIntSet is a concurrency-safe variant of IntSet. Its zero value is an
+// empty, usable set. It guards its underlying map with a sync.RWMutex so
+// that it may safely be shared across goroutines.
+type SyncIntSet struct {
+	mu sync.RWMutex
+	s  IntSet
+}
+
+// NewSyncIntSet returns a new, empty SyncIntSet.
+func NewSyncIntSet() *SyncIntSet {
+	return &SyncIntSet{s: make(IntSet)}
+}
+
+func (s *SyncIntSet) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Len()
+}
+
+func (s *SyncIntSet) Contains(x interface{}) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Contains(x)
+}
+
+func (s *SyncIntSet) Insert(x interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.s == nil {
+		s.s = make(IntSet)
+	}
+	s.s.Insert(x)
+}
+
+func (s *SyncIntSet) Remove(x interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.s.Remove(x)
+}
+
+func (s *SyncIntSet) Iterator() Iterator {
+	return s.Clone().Iterator()
+}
+
+// Deprecated: use Iterator instead.
+func (s *SyncIntSet) Values() <-chan interface{} {
+	return s.Clone().Values()
+}
+
+// Clone returns a snapshot copy of the set as a plain IntSet, so that callers
+// can iterate over or otherwise inspect it without holding the SyncIntSet's
+// lock.
+func (s *SyncIntSet) Clone() IntSet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c := make(IntSet, len(s.s))
+	for k, v := range s.s {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,46 @@
+package set
+
+import (
+	"testing"
+)
+
+func TestSyncSet(t *testing.T) {
+	a := NewSyncStringSet()
+	a.Insert("a")
+	a.Insert("b")
+	a.Insert("c")
+	b := NewSyncStringSet()
+	b.Insert("b")
+	b.Insert("c")
+	b.Insert("d")
+
+	u := Union(a, b)
+	uvals := []interface{}{"a", "b", "c", "d"}
+	checkSet(u, uvals, t)
+
+	i := Intersection(a, b)
+	ivals := []interface{}{"b", "c"}
+	checkSet(i, ivals, t)
+
+	clone := a.Clone()
+	if clone.Len() != a.Len() {
+		t.Errorf("expected clone of len %d, got %d", a.Len(), clone.Len())
+	}
+}
+
+func TestSyncSetConcurrent(t *testing.T) {
+	s := NewSyncStringSet()
+	done := make(chan struct{})
+	for i := 0; i < 10; i++ {
+		go func(n int) {
+			s.Insert(string(rune('a' + n)))
+			done <- struct{}{}
+		}(i)
+	}
+	for i := 0; i < 10; i++ {
+		<-done
+	}
+	if s.Len() != 10 {
+		t.Errorf("expected 10 elements, got %d", s.Len())
+	}
+}